@@ -0,0 +1,67 @@
+// Package colorschemes holds the named color palettes selectable via
+// --colorscheme, replacing the hardcoded lipgloss.Color literals that used
+// to live directly in main.go's style definitions.
+package colorschemes
+
+import "github.com/charmbracelet/lipgloss"
+
+// Scheme is the set of colors a palette assigns to the UI's styles: the
+// title/border accent, the progress bar gradient endpoints, and the two
+// text colors.
+type Scheme struct {
+	Primary   lipgloss.Color // title background, box borders
+	Secondary lipgloss.Color // progress gradient start
+	Tertiary  lipgloss.Color // progress gradient end
+	TitleFg   lipgloss.Color
+	TextFg    lipgloss.Color
+}
+
+// Default mirrors the colors Shoku shipped with before colorschemes existed.
+var Default = Scheme{
+	Primary:   "#007ACC",
+	Secondary: "#60bfff",
+	Tertiary:  "#bfe5ff",
+	TitleFg:   "#FFFFFF",
+	TextFg:    "#FFFFFF",
+}
+
+var Monokai = Scheme{
+	Primary:   "#f92672",
+	Secondary: "#a6e22e",
+	Tertiary:  "#e6db74",
+	TitleFg:   "#f8f8f2",
+	TextFg:    "#f8f8f2",
+}
+
+var Nord = Scheme{
+	Primary:   "#5e81ac",
+	Secondary: "#88c0d0",
+	Tertiary:  "#8fbcbb",
+	TitleFg:   "#eceff4",
+	TextFg:    "#d8dee9",
+}
+
+var Solarized = Scheme{
+	Primary:   "#268bd2",
+	Secondary: "#2aa198",
+	Tertiary:  "#859900",
+	TitleFg:   "#fdf6e3",
+	TextFg:    "#93a1a1",
+}
+
+// ByName resolves a --colorscheme flag value to a Scheme. An empty name
+// resolves to Default.
+func ByName(name string) (Scheme, bool) {
+	switch name {
+	case "", "default":
+		return Default, true
+	case "monokai":
+		return Monokai, true
+	case "nord":
+		return Nord, true
+	case "solarized":
+		return Solarized, true
+	default:
+		return Scheme{}, false
+	}
+}
@@ -0,0 +1,31 @@
+package colorschemes
+
+import "testing"
+
+func TestByNameKnownSchemes(t *testing.T) {
+	cases := []struct {
+		name string
+		want Scheme
+	}{
+		{"", Default},
+		{"default", Default},
+		{"monokai", Monokai},
+		{"nord", Nord},
+		{"solarized", Solarized},
+	}
+	for _, c := range cases {
+		got, ok := ByName(c.name)
+		if !ok {
+			t.Errorf("ByName(%q) returned ok=false, want true", c.name)
+		}
+		if got != c.want {
+			t.Errorf("ByName(%q) = %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestByNameUnknown(t *testing.T) {
+	if _, ok := ByName("does-not-exist"); ok {
+		t.Fatal("ByName(\"does-not-exist\") returned ok=true, want false")
+	}
+}
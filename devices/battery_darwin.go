@@ -0,0 +1,67 @@
+//go:build darwin
+// +build darwin
+
+package devices
+
+import (
+	"context"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var pmsetBatteryLine = regexp.MustCompile(`(\d+)%;\s*(charging|discharging|charged)`)
+
+// batteryCollector shells out to pmset, macOS's own battery reporting tool,
+// since charge state isn't exposed through a simple file tree the way it is
+// on Linux.
+type batteryCollector struct {
+	snap BatterySnapshot
+}
+
+// NewBattery returns a Darwin pmset-backed Battery collector.
+func NewBattery() Battery {
+	return &batteryCollector{}
+}
+
+func (b *batteryCollector) Update(ctx context.Context) error {
+	out, err := exec.CommandContext(ctx, "pmset", "-g", "batt").Output()
+	if err != nil {
+		b.snap = BatterySnapshot{Present: false}
+		return nil
+	}
+
+	match := pmsetBatteryLine.FindStringSubmatch(string(out))
+	if match == nil {
+		b.snap = BatterySnapshot{Present: false}
+		return nil
+	}
+
+	percent, err := strconv.Atoi(match[1])
+	if err != nil {
+		return err
+	}
+
+	// pmset doesn't report wattage directly, so RateW is a sign-only
+	// charging/discharging indicator rather than a measured rate.
+	charging := strings.EqualFold(match[2], "charging")
+	rateW := 0.0
+	if charging {
+		rateW = 1
+	} else if strings.EqualFold(match[2], "discharging") {
+		rateW = -1
+	}
+
+	b.snap = BatterySnapshot{
+		Present:  true,
+		Percent:  float64(percent),
+		Charging: charging,
+		RateW:    rateW,
+	}
+	return nil
+}
+
+func (b *batteryCollector) Snapshot() BatterySnapshot {
+	return b.snap
+}
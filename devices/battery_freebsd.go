@@ -0,0 +1,62 @@
+//go:build freebsd
+// +build freebsd
+
+package devices
+
+import (
+	"context"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// batteryCollector reads FreeBSD's ACPI battery sysctl nodes.
+type batteryCollector struct {
+	snap BatterySnapshot
+}
+
+// NewBattery returns a FreeBSD sysctl-backed Battery collector.
+func NewBattery() Battery {
+	return &batteryCollector{}
+}
+
+func (b *batteryCollector) Update(ctx context.Context) error {
+	life, err := sysctlInt(ctx, "hw.acpi.battery.life")
+	if err != nil {
+		b.snap = BatterySnapshot{Present: false}
+		return nil
+	}
+
+	state, err := sysctlInt(ctx, "hw.acpi.battery.state")
+	if err != nil {
+		state = 0
+	}
+	rate, _ := sysctlInt(ctx, "hw.acpi.battery.rate")
+
+	// hw.acpi.battery.state: 1 = discharging, 2 = charging.
+	charging := state == 2
+	rateW := float64(rate) / 1000
+	if !charging {
+		rateW = -rateW
+	}
+
+	b.snap = BatterySnapshot{
+		Present:  true,
+		Percent:  float64(life),
+		Charging: charging,
+		RateW:    rateW,
+	}
+	return nil
+}
+
+func (b *batteryCollector) Snapshot() BatterySnapshot {
+	return b.snap
+}
+
+func sysctlInt(ctx context.Context, name string) (int, error) {
+	out, err := exec.CommandContext(ctx, "sysctl", "-n", name).Output()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(out)))
+}
@@ -0,0 +1,77 @@
+//go:build linux
+// +build linux
+
+package devices
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const powerSupplyRoot = "/sys/class/power_supply"
+
+// batteryCollector reads charge state out of /sys/class/power_supply, the
+// same source upower and acpi use on Linux.
+type batteryCollector struct {
+	snap BatterySnapshot
+}
+
+// NewBattery returns a Linux power_supply-backed Battery collector.
+func NewBattery() Battery {
+	return &batteryCollector{}
+}
+
+func (b *batteryCollector) Update(ctx context.Context) error {
+	entries, err := os.ReadDir(powerSupplyRoot)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "BAT") {
+			continue
+		}
+		dir := filepath.Join(powerSupplyRoot, entry.Name())
+
+		capacity, err := readIntFile(filepath.Join(dir, "capacity"))
+		if err != nil {
+			continue
+		}
+		status, _ := os.ReadFile(filepath.Join(dir, "status"))
+
+		voltageUV, _ := readIntFile(filepath.Join(dir, "voltage_now"))
+		currentUA, _ := readIntFile(filepath.Join(dir, "current_now"))
+		rateW := float64(voltageUV) * float64(currentUA) / 1e12 // µV * µA -> W
+
+		charging := strings.TrimSpace(string(status)) == "Charging"
+		if !charging {
+			rateW = -rateW
+		}
+
+		b.snap = BatterySnapshot{
+			Present:  true,
+			Percent:  float64(capacity),
+			Charging: charging,
+			RateW:    rateW,
+		}
+		return nil
+	}
+
+	b.snap = BatterySnapshot{Present: false}
+	return nil
+}
+
+func (b *batteryCollector) Snapshot() BatterySnapshot {
+	return b.snap
+}
+
+func readIntFile(path string) (int, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(raw)))
+}
@@ -0,0 +1,49 @@
+package devices
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/load"
+)
+
+// cpuCollector implements CPU on top of gopsutil, which already handles the
+// per-OS differences itself.
+type cpuCollector struct {
+	snap CPUSnapshot
+}
+
+// NewCPU returns a CPU collector.
+func NewCPU() CPU {
+	return &cpuCollector{}
+}
+
+func (c *cpuCollector) Update(ctx context.Context) error {
+	perCore, err := cpu.PercentWithContext(ctx, time.Second, true)
+	if err != nil {
+		return err
+	}
+
+	var sum float64
+	for _, p := range perCore {
+		sum += p
+	}
+	total := 0.0
+	if len(perCore) > 0 {
+		total = sum / float64(len(perCore))
+	}
+
+	snap := CPUSnapshot{Total: total, PerCore: perCore}
+	if avg, err := load.AvgWithContext(ctx); err == nil {
+		snap.Load1, snap.Load5, snap.Load15 = avg.Load1, avg.Load5, avg.Load15
+		snap.LoadOK = true
+	}
+
+	c.snap = snap
+	return nil
+}
+
+func (c *cpuCollector) Snapshot() CPUSnapshot {
+	return c.snap
+}
@@ -0,0 +1,78 @@
+// Package devices isolates OS-specific collection behind small interfaces
+// so the UI layer in main.go reads CPU, memory, disk, temperature and
+// battery state without calling gopsutil (or raw /sys, SMC, sysctl)
+// directly. Host info in getSysInfo and the process table in proc.go are
+// read with gopsutil directly, not through this package. Adding a new
+// device (GPU, network, swap) means adding a new collector here, not
+// touching model.Update.
+package devices
+
+import "context"
+
+// CPUSnapshot is the state of a CPU collector as of its last Update.
+type CPUSnapshot struct {
+	Total   float64 // aggregate usage percentage across all cores
+	PerCore []float64
+	Load1   float64
+	Load5   float64
+	Load15  float64
+	LoadOK  bool // whether Load1/5/15 were actually populated this Update
+}
+
+// CPU collects aggregate and per-core usage plus system load averages.
+type CPU interface {
+	Update(ctx context.Context) error
+	Snapshot() CPUSnapshot
+}
+
+// MemSnapshot is the state of a Mem collector as of its last Update.
+type MemSnapshot struct {
+	UsedGB  float64
+	TotalGB float64
+}
+
+// Mem collects virtual memory usage.
+type Mem interface {
+	Update(ctx context.Context) error
+	Snapshot() MemSnapshot
+}
+
+// DiskInfo is the usage of a single mounted partition.
+type DiskInfo struct {
+	Mountpoint string
+	UsedGB     float64
+	TotalGB    float64
+}
+
+// Disk collects usage for every mounted partition.
+type Disk interface {
+	Update(ctx context.Context) error
+	Snapshot() []DiskInfo
+}
+
+// TempSensor is a single named temperature reading.
+type TempSensor struct {
+	Label   string
+	Celsius float64
+}
+
+// Temp collects readings from the host's thermal sensors. Implementations
+// are build-tagged per OS.
+type Temp interface {
+	Update(ctx context.Context) error
+	Snapshot() []TempSensor
+}
+
+// BatterySnapshot is the state of the system battery, if any.
+type BatterySnapshot struct {
+	Present  bool
+	Percent  float64
+	Charging bool
+	RateW    float64 // charge/discharge rate in watts, positive while charging
+}
+
+// Battery collects charge state. Implementations are build-tagged per OS.
+type Battery interface {
+	Update(ctx context.Context) error
+	Snapshot() BatterySnapshot
+}
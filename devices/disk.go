@@ -0,0 +1,44 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// diskCollector implements Disk on top of gopsutil.
+type diskCollector struct {
+	snap []DiskInfo
+}
+
+// NewDisk returns a Disk collector.
+func NewDisk() Disk {
+	return &diskCollector{}
+}
+
+func (d *diskCollector) Update(ctx context.Context) error {
+	partitions, err := disk.PartitionsWithContext(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	snap := make([]DiskInfo, 0, len(partitions))
+	for _, p := range partitions {
+		usage, err := disk.UsageWithContext(ctx, p.Mountpoint)
+		if err != nil {
+			continue
+		}
+		snap = append(snap, DiskInfo{
+			Mountpoint: p.Mountpoint,
+			UsedGB:     float64(usage.Used) / (1024 * 1024 * 1024),
+			TotalGB:    float64(usage.Total) / (1024 * 1024 * 1024),
+		})
+	}
+
+	d.snap = snap
+	return nil
+}
+
+func (d *diskCollector) Snapshot() []DiskInfo {
+	return d.snap
+}
@@ -0,0 +1,33 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/mem"
+)
+
+// memCollector implements Mem on top of gopsutil.
+type memCollector struct {
+	snap MemSnapshot
+}
+
+// NewMem returns a Mem collector.
+func NewMem() Mem {
+	return &memCollector{}
+}
+
+func (m *memCollector) Update(ctx context.Context) error {
+	stat, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	m.snap = MemSnapshot{
+		UsedGB:  float64(stat.Used) / (1024 * 1024 * 1024),
+		TotalGB: float64(stat.Total) / (1024 * 1024 * 1024),
+	}
+	return nil
+}
+
+func (m *memCollector) Snapshot() MemSnapshot {
+	return m.snap
+}
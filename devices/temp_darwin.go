@@ -0,0 +1,55 @@
+//go:build darwin
+// +build darwin
+
+package devices
+
+/*
+#cgo LDFLAGS: -framework IOKit -framework CoreFoundation
+#include "smc_darwin.h"
+*/
+import "C"
+
+import (
+	"context"
+	"errors"
+)
+
+var errSMCUnavailable = errors.New("devices: AppleSMC service unavailable")
+
+// tempCollector reads CPU die temperature off the SMC (System Management
+// Controller), the same mechanism powermetrics and iStat Menus use, since
+// Darwin exposes no sysfs-style thermal tree.
+type tempCollector struct {
+	snap []TempSensor
+}
+
+// NewTemp returns a Darwin SMC-backed Temp collector.
+func NewTemp() Temp {
+	return &tempCollector{}
+}
+
+func (t *tempCollector) Update(ctx context.Context) error {
+	conn := C.smc_open()
+	if conn == 0 {
+		return errSMCUnavailable
+	}
+	defer C.smc_close(conn)
+
+	var snap []TempSensor
+	for _, key := range []string{"TC0P", "TC0H", "TC0D"} {
+		ckey := C.CString(key)
+		celsius := float64(C.smc_read_temperature(conn, ckey))
+		C.free_cstring(ckey)
+		if celsius <= 0 {
+			continue
+		}
+		snap = append(snap, TempSensor{Label: key, Celsius: celsius})
+	}
+
+	t.snap = snap
+	return nil
+}
+
+func (t *tempCollector) Snapshot() []TempSensor {
+	return t.snap
+}
@@ -0,0 +1,51 @@
+//go:build freebsd
+// +build freebsd
+
+package devices
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const maxProbedCores = 64
+
+// tempCollector shells out to sysctl for each core's dev.cpu.N.temperature
+// node, which coretemp(4)/amdtemp(4) expose on FreeBSD.
+type tempCollector struct {
+	snap []TempSensor
+}
+
+// NewTemp returns a FreeBSD sysctl-backed Temp collector.
+func NewTemp() Temp {
+	return &tempCollector{}
+}
+
+func (t *tempCollector) Update(ctx context.Context) error {
+	var snap []TempSensor
+	for core := 0; core < maxProbedCores; core++ {
+		name := fmt.Sprintf("dev.cpu.%d.temperature", core)
+		out, err := exec.CommandContext(ctx, "sysctl", "-n", name).Output()
+		if err != nil {
+			break // no more cores once the node stops existing
+		}
+
+		raw := strings.TrimSpace(string(out))
+		raw = strings.TrimSuffix(raw, "C")
+		celsius, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			continue
+		}
+		snap = append(snap, TempSensor{Label: fmt.Sprintf("Core %d", core), Celsius: celsius})
+	}
+
+	t.snap = snap
+	return nil
+}
+
+func (t *tempCollector) Snapshot() []TempSensor {
+	return t.snap
+}
@@ -0,0 +1,78 @@
+//go:build linux
+// +build linux
+
+package devices
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const hwmonRoot = "/sys/class/hwmon"
+
+// tempCollector reads sensor labels and millidegree readings straight out of
+// /sys/class/hwmon, the same source `sensors` uses on Linux.
+type tempCollector struct {
+	snap []TempSensor
+}
+
+// NewTemp returns a Linux hwmon-backed Temp collector.
+func NewTemp() Temp {
+	return &tempCollector{}
+}
+
+func (t *tempCollector) Update(ctx context.Context) error {
+	chips, err := os.ReadDir(hwmonRoot)
+	if err != nil {
+		return err
+	}
+
+	var snap []TempSensor
+	for _, chip := range chips {
+		chipDir := filepath.Join(hwmonRoot, chip.Name())
+		entries, err := os.ReadDir(chipDir)
+		if err != nil {
+			continue
+		}
+
+		chipName := chip.Name()
+		if raw, err := os.ReadFile(filepath.Join(chipDir, "name")); err == nil {
+			chipName = strings.TrimSpace(string(raw))
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if !strings.HasSuffix(name, "_input") || !strings.HasPrefix(name, "temp") {
+				continue
+			}
+
+			raw, err := os.ReadFile(filepath.Join(chipDir, name))
+			if err != nil {
+				continue
+			}
+			milli, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+			if err != nil {
+				continue
+			}
+
+			label := chipName
+			labelFile := strings.TrimSuffix(name, "_input") + "_label"
+			if raw, err := os.ReadFile(filepath.Join(chipDir, labelFile)); err == nil {
+				label = fmt.Sprintf("%s %s", chipName, strings.TrimSpace(string(raw)))
+			}
+
+			snap = append(snap, TempSensor{Label: label, Celsius: float64(milli) / 1000})
+		}
+	}
+
+	t.snap = snap
+	return nil
+}
+
+func (t *tempCollector) Snapshot() []TempSensor {
+	return t.snap
+}
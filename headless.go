@@ -0,0 +1,41 @@
+package main
+
+import "context"
+
+// runHeadless drives the same devices collectors used by the TUI (CPU,
+// memory, disk, and process sampling) on a one-second cadence,
+// without starting a tea.Program, so Shoku can run on a node without a TTY.
+//
+// The cadence comes from m.cpuDev.Update itself, which blocks for roughly a
+// second (cpu.PercentWithContext sampling over that interval) — there is no
+// separate ticker, since one would just queue up behind the still-running
+// sample and double the real period to ~2s.
+func runHeadless() {
+	m := initialModel()
+
+	for {
+		if err := m.cpuDev.Update(context.Background()); err == nil {
+			snap := m.cpuDev.Snapshot()
+			m.cpuUsage = snap.Total
+			m.cpuUsages = snap.PerCore
+		}
+
+		if usedGB, totalGB, err := refreshMem(m.memDev); err == nil {
+			m.memUsed, m.memTotal = usedGB, totalGB
+		}
+
+		if err := m.diskDev.Update(context.Background()); err == nil {
+			m.disks = nil
+			for _, info := range m.diskDev.Snapshot() {
+				m.disks = append(m.disks, Disk{Mountpoint: info.Mountpoint, Used: info.UsedGB, Total: info.TotalGB})
+			}
+		}
+
+		if procs, ok := fetchProcesses()().(procListMsg); ok {
+			m.procs.all = []procInfo(procs)
+			m.procs.applyFilter()
+		}
+
+		updateMetrics(m)
+	}
+}
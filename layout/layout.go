@@ -0,0 +1,137 @@
+// Package layout parses a TOML config describing rows of named widgets and
+// their relative weights, and arranges their rendered content into the
+// lipgloss tree that main.go's View used to build by hand.
+package layout
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Row is one horizontal strip of the screen: a list of widget names and
+// their relative width weights, e.g. widgets=["cpu","mem"] weights=[1,1].
+type Row struct {
+	Widgets []string `toml:"widgets"`
+	Weights []int    `toml:"weights"`
+}
+
+// Config is the full screen layout, top row first.
+type Config struct {
+	Row []Row `toml:"row"`
+}
+
+// Default matches the layout Shoku rendered before the layout engine
+// existed: title and system info full-width, CPU/memory side by side, disks
+// full-width (it manages its own internal columns), temperature/battery
+// side by side, and the process table full-width.
+func Default() Config {
+	return Config{Row: []Row{
+		{Widgets: []string{"title"}, Weights: []int{1}},
+		{Widgets: []string{"info"}, Weights: []int{1}},
+		{Widgets: []string{"cpu", "mem"}, Weights: []int{1, 1}},
+		{Widgets: []string{"disks"}, Weights: []int{1}},
+		{Widgets: []string{"temp", "battery"}, Weights: []int{1, 1}},
+		{Widgets: []string{"procs"}, Weights: []int{1}},
+	}}
+}
+
+// DefaultPath returns the XDG config location for Shoku's config file:
+// $XDG_CONFIG_HOME/shoku/config.toml, falling back to ~/.config when
+// XDG_CONFIG_HOME is unset.
+func DefaultPath() string {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			base = filepath.Join(home, ".config")
+		}
+	}
+	return filepath.Join(base, "shoku", "config.toml")
+}
+
+// Load reads and parses a config.toml from path.
+func Load(path string) (Config, error) {
+	var cfg Config
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// WriteDefault writes Default() to path as TOML, creating parent
+// directories as needed.
+func WriteDefault(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return toml.NewEncoder(f).Encode(Default())
+}
+
+// WithoutWidget returns a copy of cfg with any row containing the named
+// widget removed. Callers use this to measure the height of "everything but
+// X" before rendering X into the remaining space.
+func (cfg Config) WithoutWidget(name string) Config {
+	var rows []Row
+	for _, row := range cfg.Row {
+		skip := false
+		for _, w := range row.Widgets {
+			if w == name {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			rows = append(rows, row)
+		}
+	}
+	return Config{Row: rows}
+}
+
+// Render arranges renderWidget's output for every widget named in cfg into
+// the full lipgloss tree: JoinHorizontal within a row, JoinVertical across
+// rows. Each widget's width is its row's totalWidth split proportionally to
+// its weight.
+func Render(cfg Config, totalWidth int, renderWidget func(name string, width int) string) string {
+	rowStrs := make([]string, 0, len(cfg.Row))
+	for _, row := range cfg.Row {
+		widths := rowWidths(row.Weights, len(row.Widgets), totalWidth)
+		cells := make([]string, 0, len(row.Widgets))
+		for i, name := range row.Widgets {
+			cells = append(cells, renderWidget(name, widths[i]))
+		}
+		rowStrs = append(rowStrs, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Top, rowStrs...)
+}
+
+// rowWidths splits totalWidth proportionally to weights. A missing or
+// mismatched weights slice falls back to an equal split across n widgets.
+func rowWidths(weights []int, n, totalWidth int) []int {
+	if len(weights) != n {
+		weights = make([]int, n)
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+
+	sum := 0
+	for _, w := range weights {
+		sum += w
+	}
+	if sum == 0 {
+		sum = n
+	}
+
+	widths := make([]int, n)
+	for i, w := range weights {
+		widths[i] = totalWidth * w / sum
+	}
+	return widths
+}
@@ -0,0 +1,76 @@
+package layout
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestRowWidthsEqualSplit(t *testing.T) {
+	widths := rowWidths(nil, 2, 100)
+	if len(widths) != 2 || widths[0] != 50 || widths[1] != 50 {
+		t.Fatalf("rowWidths(nil, 2, 100) = %v, want [50 50]", widths)
+	}
+}
+
+func TestRowWidthsMismatchedWeightsFallBackToEqualSplit(t *testing.T) {
+	widths := rowWidths([]int{1}, 3, 90)
+	want := []int{30, 30, 30}
+	for i, w := range want {
+		if widths[i] != w {
+			t.Fatalf("rowWidths with mismatched weights = %v, want %v", widths, want)
+		}
+	}
+}
+
+func TestRowWidthsProportional(t *testing.T) {
+	widths := rowWidths([]int{1, 3}, 2, 100)
+	if widths[0] != 25 || widths[1] != 75 {
+		t.Fatalf("rowWidths([1,3], 2, 100) = %v, want [25 75]", widths)
+	}
+}
+
+func TestWithoutWidgetDropsOnlyMatchingRows(t *testing.T) {
+	cfg := Default()
+	got := cfg.WithoutWidget("procs")
+	for _, row := range got.Row {
+		for _, w := range row.Widgets {
+			if w == "procs" {
+				t.Fatalf("WithoutWidget(\"procs\") left a procs row in %+v", got)
+			}
+		}
+	}
+	if len(got.Row) != len(cfg.Row)-1 {
+		t.Fatalf("WithoutWidget(\"procs\") removed %d rows, want 1", len(cfg.Row)-len(got.Row))
+	}
+}
+
+func TestRenderJoinsEveryWidget(t *testing.T) {
+	cfg := Config{Row: []Row{
+		{Widgets: []string{"a", "b"}, Weights: []int{1, 1}},
+	}}
+	var seen []string
+	out := Render(cfg, 80, func(name string, width int) string {
+		seen = append(seen, name)
+		return name
+	})
+	if len(seen) != 2 || seen[0] != "a" || seen[1] != "b" {
+		t.Fatalf("Render visited widgets %v, want [a b]", seen)
+	}
+	if out == "" {
+		t.Fatal("Render returned an empty string")
+	}
+}
+
+func TestWriteDefaultThenLoadRoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := WriteDefault(path); err != nil {
+		t.Fatalf("WriteDefault: %v", err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(cfg.Row) != len(Default().Row) {
+		t.Fatalf("round-tripped config has %d rows, want %d", len(cfg.Row), len(Default().Row))
+	}
+}
@@ -1,19 +1,38 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/shirou/gopsutil/cpu"
-	"github.com/shirou/gopsutil/disk"
 	"github.com/shirou/gopsutil/host"
-	"github.com/shirou/gopsutil/mem"
+
+	"github.com/ch1kulya/Shoku/colorschemes"
+	"github.com/ch1kulya/Shoku/devices"
+	"github.com/ch1kulya/Shoku/layout"
+	tr "github.com/ch1kulya/Shoku/translations"
+)
+
+// activeScheme and activeLayout are set once in main (or left at their
+// defaults in tests) before initialModel or View run.
+var (
+	activeScheme = colorschemes.Default
+	activeLayout = layout.Default()
 )
 
+// historyLen is the number of per-core samples kept for the CPU sparklines.
+const historyLen = 30
+
+// sparkChars maps a 0-100 percentage into the Unicode block range used to
+// draw sparklines.
+var sparkChars = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
 // Disk represents a single disk's usage information.
 type Disk struct {
 	Mountpoint string
@@ -24,23 +43,89 @@ type Disk struct {
 
 // model holds the state of the application.
 type model struct {
-	cpuUsage    float64
-	memUsed     float64
-	memTotal    float64
-	disks       []Disk
-	sysInfo     string
-	width       int
-	height      int
-	err         error
-	cpuProgress progress.Model
-	memProgress progress.Model
+	cpuUsage       float64
+	cpuUsages      []float64
+	cpuHistory     [][]float64
+	memUsed        float64
+	memTotal       float64
+	disks          []Disk
+	sysInfo        string
+	width          int
+	height         int
+	err            error
+	cpuProgress    progress.Model
+	coreProgresses []progress.Model
+	memProgress    progress.Model
+	procs          procModel
+
+	cpuDev          devices.CPU
+	memDev          devices.Mem
+	diskDev         devices.Disk
+	tempDev         devices.Temp
+	batteryDev      devices.Battery
+	temps           []devices.TempSensor
+	tempProgresses  []progress.Model
+	battery         devices.BatterySnapshot
+	batteryProgress progress.Model
+}
+
+// maxSensorTemp caps the gauge scale used for per-sensor temperature bars.
+const maxSensorTemp = 100.0
+
+// cpuUsageMsg carries the aggregate CPU percentage along with a per-core
+// breakdown for a single sample.
+type cpuUsageMsg struct {
+	total   float64
+	perCore []float64
 }
 
-// Messages for updating CPU usage and ticking.
-type cpuUsageMsg float64
 type tickMsg time.Time
 
 func main() {
+	metricsAddr := flag.String("metrics-addr", "", "address to expose Prometheus metrics on, e.g. :9090 (disabled if empty)")
+	headlessFlag := flag.Bool("headless", false, "run the collection loop and metrics exporter without a TUI")
+	configPath := flag.String("config", "", "path to config.toml (default: $XDG_CONFIG_HOME/shoku/config.toml)")
+	writeConfig := flag.Bool("write-config", false, "write the default config.toml to --config (or its default path) and exit")
+	colorscheme := flag.String("colorscheme", "default", "color scheme: default, monokai, nord, solarized")
+	lang := flag.String("lang", "", "locale to use, e.g. en_US, de_DE, ru_RU, zh_CN (default: auto-detect from $LC_ALL/$LANG)")
+	flag.Parse()
+
+	tr.SelectLocale(*lang)
+
+	path := *configPath
+	if path == "" {
+		path = layout.DefaultPath()
+	}
+
+	if *writeConfig {
+		if err := layout.WriteDefault(path); err != nil {
+			fmt.Println("Error writing config:", err)
+			os.Exit(1)
+		}
+		fmt.Println("Wrote default config to", path)
+		return
+	}
+
+	scheme, ok := colorschemes.ByName(*colorscheme)
+	if !ok {
+		fmt.Println("Unknown colorscheme:", *colorscheme)
+		os.Exit(1)
+	}
+	applyColorscheme(scheme)
+
+	if cfg, err := layout.Load(path); err == nil {
+		activeLayout = cfg
+	}
+
+	if *metricsAddr != "" {
+		startMetricsServer(*metricsAddr)
+	}
+
+	if *headlessFlag {
+		runHeadless()
+		return
+	}
+
 	p := tea.NewProgram(initialModel())
 	if err := p.Start(); err != nil {
 		fmt.Println("Error starting program:", err)
@@ -48,34 +133,58 @@ func main() {
 	}
 }
 
+// applyColorscheme repoints the package-level styles and activeScheme at
+// the chosen palette. Must run before initialModel builds any progress
+// bars.
+func applyColorscheme(scheme colorschemes.Scheme) {
+	activeScheme = scheme
+	titleStyle = titleStyle.Foreground(scheme.TitleFg).Background(scheme.Primary)
+	infoStyle = infoStyle.Foreground(scheme.TextFg)
+	boxStyle = boxStyle.BorderForeground(scheme.Primary)
+}
+
+// newProgressModel builds a progress bar using the active colorscheme's
+// gradient, replacing the repeated "#60bfff"/"#bfe5ff" literals.
+func newProgressModel() progress.Model {
+	return progress.New(
+		progress.WithDefaultGradient(),
+		progress.WithScaledGradient(string(activeScheme.Secondary), string(activeScheme.Tertiary)),
+	)
+}
+
 // initialModel initializes the application state.
 func initialModel() model {
 	// Initialize progress models with custom styles.
-	cpuP := progress.New(progress.WithDefaultGradient(), progress.WithScaledGradient("#60bfff", "#bfe5ff"))
-	memP := progress.New(progress.WithDefaultGradient(), progress.WithScaledGradient("#60bfff", "#bfe5ff"))
+	cpuP := newProgressModel()
+	memP := newProgressModel()
+
+	diskDev := devices.NewDisk()
 
 	// Initialize disk progress models for all mounted partitions.
-	partitions, err := disk.Partitions(false)
 	var disks []Disk
-	if err == nil {
-		for _, p := range partitions {
-			usage, err := disk.Usage(p.Mountpoint)
-			if err == nil {
-				diskProgress := progress.New(progress.WithDefaultGradient(), progress.WithScaledGradient("#60bfff", "#bfe5ff"))
-				disks = append(disks, Disk{
-					Mountpoint: p.Mountpoint,
-					Used:       float64(usage.Used) / (1024 * 1024 * 1024), // Convert bytes to GB
-					Total:      float64(usage.Total) / (1024 * 1024 * 1024),
-					Progress:   diskProgress,
-				})
-			}
+	if err := diskDev.Update(context.Background()); err == nil {
+		for _, info := range diskDev.Snapshot() {
+			diskProgress := newProgressModel()
+			disks = append(disks, Disk{
+				Mountpoint: info.Mountpoint,
+				Used:       info.UsedGB,
+				Total:      info.TotalGB,
+				Progress:   diskProgress,
+			})
 		}
 	}
 
 	return model{
-		cpuProgress: cpuP,
-		memProgress: memP,
-		disks:       disks,
+		cpuProgress:     cpuP,
+		memProgress:     memP,
+		disks:           disks,
+		batteryProgress: newProgressModel(),
+
+		cpuDev:     devices.NewCPU(),
+		memDev:     devices.NewMem(),
+		diskDev:    diskDev,
+		tempDev:    devices.NewTemp(),
+		batteryDev: devices.NewBattery(),
 	}
 }
 
@@ -86,7 +195,9 @@ func (m model) Init() tea.Cmd {
 		tea.EnterAltScreen,
 		m.cpuProgress.Init(),
 		m.memProgress.Init(),
-		startCPUUsageMonitor(), // Start monitoring CPU usage
+		m.batteryProgress.Init(),
+		startCPUUsageMonitor(m.cpuDev), // Start monitoring CPU usage
+		fetchProcesses(),               // Start monitoring the process table
 	}
 
 	// Initialize each disk's progress model.
@@ -97,15 +208,49 @@ func (m model) Init() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-// Command to periodically fetch CPU usage.
-func startCPUUsageMonitor() tea.Cmd {
+// Command to periodically fetch per-core CPU usage, aggregating it for the
+// overall bar as well.
+func startCPUUsageMonitor(dev devices.CPU) tea.Cmd {
 	return func() tea.Msg {
-		percent, err := cpu.Percent(1*time.Second, false)
-		if err == nil && len(percent) > 0 {
-			return cpuUsageMsg(percent[0])
+		if err := dev.Update(context.Background()); err != nil {
+			return cpuUsageMsg{}
+		}
+		snap := dev.Snapshot()
+		return cpuUsageMsg{total: snap.Total, perCore: snap.PerCore}
+	}
+}
+
+// refreshMem updates dev and returns its used/total memory in GB.
+func refreshMem(dev devices.Mem) (usedGB float64, totalGB float64, err error) {
+	if err := dev.Update(context.Background()); err != nil {
+		return 0, 0, err
+	}
+	snap := dev.Snapshot()
+	return snap.UsedGB, snap.TotalGB, nil
+}
+
+// pushSample appends a sample to a ring buffer, trimming it to max entries.
+func pushSample(samples []float64, v float64, max int) []float64 {
+	samples = append(samples, v)
+	if len(samples) > max {
+		samples = samples[len(samples)-max:]
+	}
+	return samples
+}
+
+// sparkline renders a slice of 0-100 percentages as a row of Unicode blocks.
+func sparkline(samples []float64) string {
+	var b strings.Builder
+	for _, s := range samples {
+		idx := int(s / 100 * float64(len(sparkChars)-1))
+		if idx < 0 {
+			idx = 0
+		} else if idx >= len(sparkChars) {
+			idx = len(sparkChars) - 1
 		}
-		return cpuUsageMsg(0)
+		b.WriteRune(sparkChars[idx])
 	}
+	return b.String()
 }
 
 // tickCmd returns a command that sends a tickMsg after one second.
@@ -121,73 +266,124 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case cpuUsageMsg:
-		m.cpuUsage = float64(msg)
+		// A failed dev.Update (transient cpu.Percent hiccup) comes through as
+		// a zero-value message; keep the last good snapshot instead of
+		// wiping the progress bars and sparkline history.
+		if len(msg.perCore) == 0 {
+			cmds = append(cmds, startCPUUsageMonitor(m.cpuDev))
+			break
+		}
+
+		m.cpuUsage = msg.total
+		m.cpuUsages = msg.perCore
 		cmds = append(cmds, m.cpuProgress.SetPercent(m.cpuUsage/100))
+
+		// Rebuild the per-core progress bars and history ring buffers if the
+		// core count changed (e.g. first sample, or a hot-added CPU); any
+		// earlier history is discarded.
+		if len(m.coreProgresses) != len(msg.perCore) {
+			m.coreProgresses = make([]progress.Model, len(msg.perCore))
+			m.cpuHistory = make([][]float64, len(msg.perCore))
+			for i := range m.coreProgresses {
+				m.coreProgresses[i] = newProgressModel()
+				cmds = append(cmds, m.coreProgresses[i].Init())
+			}
+		}
+		for i, p := range msg.perCore {
+			cmds = append(cmds, m.coreProgresses[i].SetPercent(p/100))
+			m.cpuHistory[i] = pushSample(m.cpuHistory[i], p, historyLen)
+		}
+
 		// Continue monitoring CPU usage
-		cmds = append(cmds, startCPUUsageMonitor())
+		cmds = append(cmds, startCPUUsageMonitor(m.cpuDev))
 
 	case tea.KeyMsg:
+		if m.procs.handleKey(msg) {
+			break
+		}
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 		}
+	case procListMsg:
+		// Only refreshes the process table; the 1Hz refresh of everything
+		// else (mem/disk/sysinfo, tickCmd rescheduling) lives in its own
+		// case tickMsg below and must stay driven by the real timer, not by
+		// fetchProcesses completing.
+		m.procs.all = []procInfo(msg)
+		m.procs.applyFilter()
+
 	case tickMsg:
 		// Update system information
 		var err error
-		m.memUsed, m.memTotal, err = getMemUsage()
+		m.memUsed, m.memTotal, err = refreshMem(m.memDev)
 		if err != nil {
 			return m, tea.Quit
 		}
-		m.sysInfo, err = getSysInfo()
+		m.sysInfo, err = getSysInfo(m.cpuDev.Snapshot())
 		if err != nil {
 			return m, tea.Quit
 		}
 
 		// Update disk usages
-		partitions, err := disk.Partitions(false)
-		if err != nil {
-			m.err = err
+		if err := m.diskDev.Update(context.Background()); err != nil {
+			m.err = fmt.Errorf("%s", tr.Value("error.diskfail", err))
 			return m, tea.Quit
 		}
 
 		// Update existing disks or add new ones
-		for _, p := range partitions {
-			usage, err := disk.Usage(p.Mountpoint)
-			if err != nil {
-				continue
-			}
-			usedGB := float64(usage.Used) / (1024 * 1024 * 1024)
-			totalGB := float64(usage.Total) / (1024 * 1024 * 1024)
-
+		for _, info := range m.diskDev.Snapshot() {
 			found := false
 			for i, d := range m.disks {
-				if d.Mountpoint == p.Mountpoint {
-					m.disks[i].Used = usedGB
-					m.disks[i].Total = totalGB
-					percent := usedGB / totalGB
-					cmds = append(cmds, m.disks[i].Progress.SetPercent(percent))
+				if d.Mountpoint == info.Mountpoint {
+					m.disks[i].Used = info.UsedGB
+					m.disks[i].Total = info.TotalGB
+					cmds = append(cmds, m.disks[i].Progress.SetPercent(info.UsedGB/info.TotalGB))
 					found = true
 					break
 				}
 			}
 			if !found {
 				// New disk found, add to the list
-				diskProgress := progress.New(progress.WithDefaultGradient(), progress.WithScaledGradient("#60bfff", "#bfe5ff"))
+				diskProgress := newProgressModel()
 				newDisk := Disk{
-					Mountpoint: p.Mountpoint,
-					Used:       usedGB,
-					Total:      totalGB,
+					Mountpoint: info.Mountpoint,
+					Used:       info.UsedGB,
+					Total:      info.TotalGB,
 					Progress:   diskProgress,
 				}
 				m.disks = append(m.disks, newDisk)
 				cmds = append(cmds, newDisk.Progress.Init())
-				cmds = append(cmds, newDisk.Progress.SetPercent(usedGB/totalGB))
+				cmds = append(cmds, newDisk.Progress.SetPercent(info.UsedGB/info.TotalGB))
 			}
 		}
 
 		// Update Memory progress
 		cmds = append(cmds, m.memProgress.SetPercent(m.memUsed/m.memTotal))
 
+		// Update temperature and battery readings.
+		if err := m.tempDev.Update(context.Background()); err == nil {
+			m.temps = m.tempDev.Snapshot()
+
+			if len(m.tempProgresses) != len(m.temps) {
+				m.tempProgresses = make([]progress.Model, len(m.temps))
+				for i := range m.tempProgresses {
+					m.tempProgresses[i] = newProgressModel()
+					cmds = append(cmds, m.tempProgresses[i].Init())
+				}
+			}
+			for i, sensor := range m.temps {
+				cmds = append(cmds, m.tempProgresses[i].SetPercent(sensor.Celsius/maxSensorTemp))
+			}
+		}
+		if err := m.batteryDev.Update(context.Background()); err == nil {
+			m.battery = m.batteryDev.Snapshot()
+			cmds = append(cmds, m.batteryProgress.SetPercent(m.battery.Percent/100))
+		}
+
+		// Refresh the process table alongside everything else.
+		cmds = append(cmds, fetchProcesses())
+
 		// Schedule the next tick
 		cmds = append(cmds, tickCmd())
 
@@ -216,6 +412,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	// Update each core's Progress
+	for i := range m.coreProgresses {
+		updatedProgress, cmd = m.coreProgresses[i].Update(msg)
+		if coreP, ok := updatedProgress.(progress.Model); ok {
+			m.coreProgresses[i] = coreP
+			cmds = append(cmds, cmd)
+		} else {
+			m.err = fmt.Errorf("failed to cast coreProgresses[%d] to progress.Model", i)
+			return m, tea.Quit
+		}
+	}
+
 	// Update each Disk's Progress
 	for i := range m.disks {
 		updatedProgress, cmd = m.disks[i].Progress.Update(msg)
@@ -228,46 +436,107 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Update each temperature sensor's Progress
+	for i := range m.tempProgresses {
+		updatedProgress, cmd = m.tempProgresses[i].Update(msg)
+		if tp, ok := updatedProgress.(progress.Model); ok {
+			m.tempProgresses[i] = tp
+			cmds = append(cmds, cmd)
+		} else {
+			m.err = fmt.Errorf("failed to cast tempProgresses[%d] to progress.Model", i)
+			return m, tea.Quit
+		}
+	}
+
+	// Update Battery Progress
+	updatedProgress, cmd = m.batteryProgress.Update(msg)
+	if batP, ok := updatedProgress.(progress.Model); ok {
+		m.batteryProgress = batP
+		cmds = append(cmds, cmd)
+	} else {
+		m.err = fmt.Errorf("failed to cast batteryProgress to progress.Model")
+		return m, tea.Quit
+	}
+
+	updateMetrics(m)
+
 	return m, tea.Batch(cmds...)
 }
 
-// View renders the UI.
+// View renders the UI by handing the active layout config a renderer for
+// each named widget; the config decides how they're arranged.
 func (m model) View() string {
 	if m.err != nil {
-		return fmt.Sprintf("An error occurred: %v\nPress q to quit.", m.err)
+		return tr.Value("error.occurred", m.err)
 	}
 
 	// Calculate content width, accounting for padding and borders
 	contentWidth := m.width - boxStyle.GetHorizontalFrameSize()
 
-	// Title
-	title := titleStyle.Width(contentWidth).Render("System Monitor")
-
-	// System Info Box
-	infoBox := boxStyle.Width(contentWidth).Render(infoStyle.Render(m.sysInfo))
+	// procVisibleRows is filled in below, before "procs" is ever rendered,
+	// by measuring every other row first.
+	procVisibleRows := 0
+
+	renderWidget := func(name string, width int) string {
+		switch name {
+		case "title":
+			return titleStyle.Width(width).Render(tr.Value("widget.title"))
+		case "info":
+			return boxStyle.Width(width).Render(infoStyle.Render(m.sysInfo))
+		case "cpu":
+			return boxStyle.Width(width).Render(m.renderCPU())
+		case "mem":
+			return boxStyle.Width(width).Render(
+				tr.Value("widget.memory", m.memUsed, m.memTotal, m.memProgress.View()),
+			)
+		case "disks":
+			return m.renderDisks(width)
+		case "temp":
+			return boxStyle.Width(width).Render(m.renderTemps())
+		case "battery":
+			return boxStyle.Width(width).Render(m.renderBattery())
+		case "procs":
+			return boxStyle.Width(width).Render(m.procs.View(width, procVisibleRows))
+		default:
+			return ""
+		}
+	}
 
-	// Calculate half width for side-by-side boxes
-	halfWidth := (contentWidth - lipgloss.Width("â”‚")*2) / 2
+	// Measure everything except the process table, then give it whatever
+	// vertical space remains.
+	measured := layout.Render(activeLayout.WithoutWidget("procs"), contentWidth, renderWidget)
+	procVisibleRows = m.height - lipgloss.Height(measured) - boxStyle.GetVerticalFrameSize() - 2 // header + status rows
 
-	// CPU Usage Box
-	cpuBox := boxStyle.Width(halfWidth).Render(
-		fmt.Sprintf("CPU Usage: %.2f%%\n%s", m.cpuUsage, m.cpuProgress.View()),
-	)
+	return layout.Render(activeLayout, contentWidth, renderWidget)
+}
 
-	// Memory Usage Box
-	memBox := boxStyle.Width(halfWidth).Render(
-		fmt.Sprintf("Memory: %.2f GB / %.2f GB\n%s", m.memUsed, m.memTotal, m.memProgress.View()),
-	)
+// renderCPU builds the aggregate bar plus one labeled row with a sparkline
+// per core.
+func (m model) renderCPU() string {
+	var coreLines []string
+	for i, usage := range m.cpuUsages {
+		bar := ""
+		if i < len(m.coreProgresses) {
+			bar = m.coreProgresses[i].View()
+		}
+		spark := ""
+		if i < len(m.cpuHistory) {
+			spark = sparkline(m.cpuHistory[i])
+		}
+		coreLines = append(coreLines, tr.Value("widget.core", i, usage, bar, spark))
+	}
+	return tr.Value("widget.cpu", m.cpuUsage, m.cpuProgress.View(), strings.Join(coreLines, "\n"))
+}
 
-	// CPU and Memory side by side
-	cpuMemRow := lipgloss.JoinHorizontal(lipgloss.Top, cpuBox, memBox)
+// renderDisks lays out every mounted partition's usage box in two columns
+// within the width the layout gave the "disks" widget.
+func (m model) renderDisks(width int) string {
+	halfWidth := (width - lipgloss.Width("│")*2) / 2
 
-	// Disks Usage Boxes
-	var leftDiskBoxes []string
-	var rightDiskBoxes []string
-	for i, disk := range m.disks {
+	var leftDiskBoxes, rightDiskBoxes []string
+	for i, d := range m.disks {
 		diskBox := boxStyle.Width(halfWidth).Render(
-			fmt.Sprintf("Disk (%s): %.2f GB / %.2f GB\n%s", disk.Mountpoint, disk.Used, disk.Total, disk.Progress.View()),
+			tr.Value("widget.disk", d.Mountpoint, d.Used, d.Total, d.Progress.View()),
 		)
 		if i%2 == 0 {
 			leftDiskBoxes = append(leftDiskBoxes, diskBox)
@@ -276,65 +545,82 @@ func (m model) View() string {
 		}
 	}
 
-	// Create left and right disk columns
 	leftDiskColumn := lipgloss.JoinVertical(lipgloss.Top, leftDiskBoxes...)
 	rightDiskColumn := lipgloss.JoinVertical(lipgloss.Top, rightDiskBoxes...)
+	return lipgloss.JoinHorizontal(lipgloss.Top, leftDiskColumn, rightDiskColumn)
+}
 
-	// Disks side by side
-	disksView := lipgloss.JoinHorizontal(lipgloss.Top, leftDiskColumn, rightDiskColumn)
-
-	// Combine all sections
-	mainContent := lipgloss.JoinVertical(lipgloss.Top, title, infoBox, cpuMemRow, disksView)
-
-	// Render the content
-	return mainContent
+// renderTemps formats one bar per thermal sensor, consistent with the
+// CPU/memory/disk boxes.
+func (m model) renderTemps() string {
+	var tempLines []string
+	for i, sensor := range m.temps {
+		bar := ""
+		if i < len(m.tempProgresses) {
+			bar = m.tempProgresses[i].View()
+		}
+		tempLines = append(tempLines, tr.Value("widget.temp_line", sensor.Label, sensor.Celsius, bar))
+	}
+	if len(tempLines) == 0 {
+		tempLines = append(tempLines, tr.Value("value.no_sensors"))
+	}
+	return tr.Value("widget.temperature", strings.Join(tempLines, "\n"))
 }
 
-// getMemUsage retrieves the used and total memory in GB.
-func getMemUsage() (usedGB float64, totalGB float64, err error) {
-	memStat, err := mem.VirtualMemory()
-	if err != nil {
-		return 0, 0, err
+// renderBattery formats the battery snapshot as a gauge plus charge rate.
+func (m model) renderBattery() string {
+	if !m.battery.Present {
+		return tr.Value("widget.battery", tr.Value("value.no_battery"))
+	}
+
+	state := tr.Value("value.discharging")
+	if m.battery.Charging {
+		state = tr.Value("value.charging")
 	}
-	usedGB = float64(memStat.Used) / (1024 * 1024 * 1024)
-	totalGB = float64(memStat.Total) / (1024 * 1024 * 1024)
-	return usedGB, totalGB, nil
+	return tr.Value("widget.battery", tr.Value("value.battery_reading", m.batteryProgress.View(), m.battery.Percent, state, m.battery.RateW))
 }
 
-// getSysInfo retrieves system information such as hostname, OS details, and uptime.
-func getSysInfo() (string, error) {
+// getSysInfo retrieves system information such as hostname, OS details, and
+// uptime. Load averages come from cpuSnap, which m.cpuDev already refreshes
+// every CPU tick, rather than a second direct gopsutil/load call.
+func getSysInfo(cpuSnap devices.CPUSnapshot) (string, error) {
 	info, err := host.Info()
 	if err != nil {
 		return "", err
 	}
 	uptime := time.Duration(info.Uptime) * time.Second
-	uptimeStr := fmt.Sprintf("%d days %d hrs %d min %d s",
+	uptimeStr := tr.Value("label.uptime_value",
 		int(uptime.Hours()/24),
 		int(uptime.Hours())%24,
 		int(uptime.Minutes())%60,
 		int(uptime.Seconds())%60)
-	return fmt.Sprintf("Hostname: %s\nOS: %s \nUptime: %s",
-		info.Hostname,
-		info.Platform,
-		uptimeStr), nil
+
+	loadStr := tr.Value("value.loadavg_na")
+	if cpuSnap.LoadOK {
+		loadStr = tr.Value("value.loadavg_reading", cpuSnap.Load1, cpuSnap.Load5, cpuSnap.Load15)
+	}
+
+	return strings.Join([]string{
+		tr.Value("label.hostname", info.Hostname),
+		tr.Value("label.os", info.Platform),
+		tr.Value("label.uptime", uptimeStr),
+		tr.Value("label.loadavg", loadStr),
+	}, "\n"), nil
 }
 
 var (
-	// Define styles using lipgloss
+	// Define styles using lipgloss. Colors come from activeScheme via
+	// applyColorscheme, called before these are first used.
 	titleStyle = lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#007ACC")).
 			Padding(0, 1).
 			Margin(0, 1).
 			Align(lipgloss.Center)
 
 	infoStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
 			Align(lipgloss.Left)
 
 	boxStyle = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			Padding(1, 1).
-			BorderForeground(lipgloss.Color("#007ACC"))
+			Padding(1, 1)
 )
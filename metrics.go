@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Gauges exposed on the Prometheus endpoint, mirroring the CPU, memory, disk
+// and process widgets in View. Temperature and battery are not exported.
+var (
+	cpuUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shoku_cpu_usage_percent",
+		Help: "CPU usage percentage per core.",
+	}, []string{"core"})
+
+	memUsedGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "shoku_memory_used_gb",
+		Help: "Used memory in gigabytes.",
+	})
+	memTotalGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "shoku_memory_total_gb",
+		Help: "Total memory in gigabytes.",
+	})
+
+	diskUsedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shoku_disk_used_gb",
+		Help: "Used disk space in gigabytes, per mountpoint.",
+	}, []string{"mountpoint"})
+	diskTotalGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shoku_disk_total_gb",
+		Help: "Total disk space in gigabytes, per mountpoint.",
+	}, []string{"mountpoint"})
+
+	procCPUGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shoku_process_cpu_percent",
+		Help: "CPU usage percentage, per process.",
+	}, []string{"pid", "command"})
+	procRSSGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shoku_process_rss_mb",
+		Help: "Resident set size in megabytes, per process.",
+	}, []string{"pid", "command"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		cpuUsageGauge,
+		memUsedGauge, memTotalGauge,
+		diskUsedGauge, diskTotalGauge,
+		procCPUGauge, procRSSGauge,
+	)
+}
+
+// startMetricsServer exposes the registered gauges in Prometheus text format
+// on addr, e.g. ":9090".
+func startMetricsServer(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			fmt.Println("Error starting metrics server:", err)
+		}
+	}()
+}
+
+// updateMetrics pushes the current snapshot of m into the gauges. It is
+// called from the same tick loop that drives the UI (model.Update) and from
+// the headless collection loop, so both paths report identical values.
+func updateMetrics(m model) {
+	for i, usage := range m.cpuUsages {
+		cpuUsageGauge.WithLabelValues(fmt.Sprintf("%d", i)).Set(usage)
+	}
+
+	memUsedGauge.Set(m.memUsed)
+	memTotalGauge.Set(m.memTotal)
+
+	for _, d := range m.disks {
+		diskUsedGauge.WithLabelValues(d.Mountpoint).Set(d.Used)
+		diskTotalGauge.WithLabelValues(d.Mountpoint).Set(d.Total)
+	}
+
+	procCPUGauge.Reset()
+	procRSSGauge.Reset()
+	for _, p := range m.procs.all {
+		pid := fmt.Sprintf("%d", p.PID)
+		procCPUGauge.WithLabelValues(pid, p.Command).Set(p.CPU)
+		procRSSGauge.WithLabelValues(pid, p.Command).Set(p.RSSMB)
+	}
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/shirou/gopsutil/process"
+
+	tr "github.com/ch1kulya/Shoku/translations"
+)
+
+// procSortMode selects which column the process table is ordered by.
+type procSortMode int
+
+const (
+	sortByCPU procSortMode = iota
+	sortByMem
+	sortByPID
+	sortByName
+)
+
+// procRowsVisible is how many process rows are shown when the terminal
+// height is unknown (e.g. before the first WindowSizeMsg arrives).
+const procRowsVisible = 10
+
+// procInfo is a single row snapshot of a running process.
+type procInfo struct {
+	PID     int32
+	User    string
+	Command string
+	CPU     float64
+	RSSMB   float64
+	Threads int32
+}
+
+// procModel is the scrollable process table, owned by the main model.
+type procModel struct {
+	all        []procInfo // unfiltered snapshot from the last refresh
+	rows       []procInfo // filtered and sorted view
+	sortMode   procSortMode
+	reverse    bool
+	filter     string
+	filterMode bool
+	selected   int
+	offset     int
+}
+
+// procListMsg carries a fresh process snapshot from fetchProcesses.
+type procListMsg []procInfo
+
+// fetchProcesses gathers PID, user, command, CPU%, RSS, and thread count for
+// every running process.
+func fetchProcesses() tea.Cmd {
+	return func() tea.Msg {
+		procs, err := process.Processes()
+		if err != nil {
+			return procListMsg(nil)
+		}
+
+		rows := make([]procInfo, 0, len(procs))
+		for _, p := range procs {
+			name, err := p.Name()
+			if err != nil {
+				continue
+			}
+			user, _ := p.Username()
+			cpuPct, _ := p.CPUPercent()
+			threads, _ := p.NumThreads()
+			var rssMB float64
+			if mem, err := p.MemoryInfo(); err == nil && mem != nil {
+				rssMB = float64(mem.RSS) / (1024 * 1024)
+			}
+			rows = append(rows, procInfo{
+				PID:     p.Pid,
+				User:    user,
+				Command: name,
+				CPU:     cpuPct,
+				RSSMB:   rssMB,
+				Threads: threads,
+			})
+		}
+		return procListMsg(rows)
+	}
+}
+
+// applySort orders rows in place according to the current sort mode and
+// direction.
+func (pm *procModel) applySort() {
+	rows := pm.rows
+	var less func(i, j int) bool
+	switch pm.sortMode {
+	case sortByMem:
+		less = func(i, j int) bool { return rows[i].RSSMB < rows[j].RSSMB }
+	case sortByPID:
+		less = func(i, j int) bool { return rows[i].PID < rows[j].PID }
+	case sortByName:
+		less = func(i, j int) bool { return rows[i].Command < rows[j].Command }
+	default: // sortByCPU
+		less = func(i, j int) bool { return rows[i].CPU < rows[j].CPU }
+	}
+	if pm.reverse {
+		sort.Slice(rows, func(i, j int) bool { return less(j, i) })
+	} else {
+		sort.Slice(rows, func(i, j int) bool { return less(i, j) })
+	}
+}
+
+// applyFilter rebuilds rows from all using the current substring filter,
+// then re-sorts, clamping the selection to the new row count.
+func (pm *procModel) applyFilter() {
+	if pm.filter == "" {
+		pm.rows = append(pm.rows[:0], pm.all...)
+	} else {
+		pm.rows = pm.rows[:0]
+		for _, p := range pm.all {
+			if strings.Contains(p.Command, pm.filter) {
+				pm.rows = append(pm.rows, p)
+			}
+		}
+	}
+	pm.applySort()
+	if pm.selected >= len(pm.rows) {
+		pm.selected = len(pm.rows) - 1
+	}
+	if pm.selected < 0 {
+		pm.selected = 0
+	}
+}
+
+// handleKey processes a key while the process table has focus. It returns
+// true if the key was consumed.
+func (pm *procModel) handleKey(msg tea.KeyMsg) bool {
+	if pm.filterMode {
+		switch msg.Type {
+		case tea.KeyEnter, tea.KeyEsc:
+			pm.filterMode = false
+		case tea.KeyBackspace:
+			if len(pm.filter) > 0 {
+				pm.filter = pm.filter[:len(pm.filter)-1]
+				pm.applyFilter()
+			}
+		case tea.KeyRunes:
+			pm.filter += string(msg.Runes)
+			pm.applyFilter()
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch msg.String() {
+	case "c":
+		pm.sortMode = sortByCPU
+	case "m":
+		pm.sortMode = sortByMem
+	case "p":
+		pm.sortMode = sortByPID
+	case "n":
+		pm.sortMode = sortByName
+	case "r":
+		pm.reverse = !pm.reverse
+	case "/":
+		pm.filterMode = true
+		return true
+	case "up":
+		if pm.selected > 0 {
+			pm.selected--
+		}
+	case "down":
+		if pm.selected < len(pm.rows)-1 {
+			pm.selected++
+		}
+	case "k":
+		pm.signalSelected(syscall.SIGTERM)
+	case "K":
+		pm.signalSelected(syscall.SIGKILL)
+	default:
+		return false
+	}
+	pm.applySort()
+	return true
+}
+
+// signalSelected sends sig to the PID currently highlighted in the table.
+func (pm *procModel) signalSelected(sig syscall.Signal) {
+	if pm.selected < 0 || pm.selected >= len(pm.rows) {
+		return
+	}
+	proc, err := os.FindProcess(int(pm.rows[pm.selected].PID))
+	if err != nil {
+		return
+	}
+	_ = proc.Signal(sig)
+}
+
+// View renders the process table, paginated to fit visibleRows.
+func (pm *procModel) View(width, visibleRows int) string {
+	if visibleRows < 1 {
+		visibleRows = procRowsVisible
+	}
+
+	// Keep the selected row scrolled into view.
+	if pm.selected < pm.offset {
+		pm.offset = pm.selected
+	}
+	if pm.selected >= pm.offset+visibleRows {
+		pm.offset = pm.selected - visibleRows + 1
+	}
+
+	header := fmt.Sprintf(tr.Value("widget.proc_header"),
+		tr.Value("label.col_pid"), tr.Value("label.col_user"), tr.Value("label.col_thr"),
+		tr.Value("label.col_cpu"), tr.Value("label.col_rss"), tr.Value("label.col_command"))
+	lines := []string{header}
+
+	end := pm.offset + visibleRows
+	if end > len(pm.rows) {
+		end = len(pm.rows)
+	}
+	for i := pm.offset; i < end; i++ {
+		p := pm.rows[i]
+		cursor := "  "
+		if i == pm.selected {
+			cursor = "> "
+		}
+		lines = append(lines, fmt.Sprintf("%s%-6d %-10s %-6d %7.1f %9.1f %-s",
+			cursor, p.PID, p.User, p.Threads, p.CPU, p.RSSMB, p.Command))
+	}
+
+	status := tr.Value("widget.proc_status", sortModeLabel(pm.sortMode), pm.reverse, pm.filter, len(pm.rows), len(pm.all))
+	if pm.filterMode {
+		status = tr.Value("widget.proc_filter_prompt", pm.filter)
+	}
+	lines = append(lines, status)
+
+	return strings.Join(lines, "\n")
+}
+
+// sortModeLabel returns the short name of a sort mode ("cpu", "mem", "pid"
+// or "name"), for the status line.
+func sortModeLabel(mode procSortMode) string {
+	switch mode {
+	case sortByMem:
+		return "mem"
+	case sortByPID:
+		return "pid"
+	case sortByName:
+		return "name"
+	default:
+		return "cpu"
+	}
+}
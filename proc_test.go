@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+func testRows() []procInfo {
+	return []procInfo{
+		{PID: 3, Command: "bash", CPU: 1.5, RSSMB: 40},
+		{PID: 1, Command: "zsh", CPU: 9.0, RSSMB: 10},
+		{PID: 2, Command: "agetty", CPU: 0.2, RSSMB: 20},
+	}
+}
+
+func TestApplySortByCPU(t *testing.T) {
+	pm := procModel{all: testRows(), rows: testRows(), sortMode: sortByCPU}
+	pm.applySort()
+	if pm.rows[0].Command != "agetty" || pm.rows[2].Command != "zsh" {
+		t.Fatalf("applySort(sortByCPU) = %+v, want ascending by CPU", pm.rows)
+	}
+}
+
+func TestApplySortReverse(t *testing.T) {
+	pm := procModel{all: testRows(), rows: testRows(), sortMode: sortByPID, reverse: true}
+	pm.applySort()
+	if pm.rows[0].PID != 3 || pm.rows[2].PID != 1 {
+		t.Fatalf("applySort(sortByPID, reverse) = %+v, want descending by PID", pm.rows)
+	}
+}
+
+func TestApplyFilterNarrowsRows(t *testing.T) {
+	pm := procModel{all: testRows(), sortMode: sortByName, filter: "sh"}
+	pm.applyFilter()
+	if len(pm.rows) != 2 {
+		t.Fatalf("applyFilter(%q) kept %d rows, want 2: %+v", pm.filter, len(pm.rows), pm.rows)
+	}
+	for _, r := range pm.rows {
+		if r.Command != "bash" && r.Command != "zsh" {
+			t.Fatalf("applyFilter(%q) kept unexpected row %+v", pm.filter, r)
+		}
+	}
+}
+
+func TestApplyFilterClampsSelection(t *testing.T) {
+	pm := procModel{all: testRows(), selected: 2, filter: "zsh"}
+	pm.applyFilter()
+	if len(pm.rows) != 1 {
+		t.Fatalf("applyFilter(%q) kept %d rows, want 1", pm.filter, len(pm.rows))
+	}
+	if pm.selected != 0 {
+		t.Fatalf("applyFilter clamped selected to %d, want 0", pm.selected)
+	}
+}
+
+func TestApplyFilterEmptyRestoresAllRows(t *testing.T) {
+	pm := procModel{all: testRows()}
+	pm.applyFilter()
+	if len(pm.rows) != len(pm.all) {
+		t.Fatalf("applyFilter(\"\") kept %d rows, want %d", len(pm.rows), len(pm.all))
+	}
+}
@@ -0,0 +1,114 @@
+// Package tr provides i18n catalogs for Shoku's UI strings. Catalogs are
+// per-locale TOML files embedded at build time, keyed by dotted paths like
+// "widget.cpu" or "error.diskfail". Value looks a key up in the selected
+// locale, falling back to en_US when the key (or the whole locale) is
+// missing.
+package tr
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+//go:embed locales/*.toml
+var localeFS embed.FS
+
+const fallbackLocale = "en_US"
+
+var (
+	active   = mustLoad(fallbackLocale)
+	fallback = active
+)
+
+// SelectLocale loads name as the active catalog. An empty name is resolved
+// from $LC_ALL or $LANG; if neither is set, or the resolved locale has no
+// catalog, en_US stays active.
+func SelectLocale(name string) {
+	if name == "" {
+		name = localeFromEnv()
+	}
+	if cat, err := load(name); err == nil {
+		active = cat
+	}
+}
+
+// Value looks key up in the active catalog (falling back to en_US), then
+// formats it with args via fmt.Sprintf. Keys with no args are returned
+// as-is. An unknown key is returned verbatim so a missing translation is
+// visible instead of panicking.
+func Value(key string, args ...interface{}) string {
+	template, ok := active[key]
+	if !ok {
+		template, ok = fallback[key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return template
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// localeFromEnv resolves a locale name from the standard POSIX locale
+// environment variables, stripping any encoding suffix (e.g.
+// "de_DE.UTF-8" -> "de_DE").
+func localeFromEnv() string {
+	for _, envVar := range []string{"LC_ALL", "LANG"} {
+		v := os.Getenv(envVar)
+		if v == "" || v == "C" || v == "POSIX" {
+			continue
+		}
+		if i := strings.IndexAny(v, ".@"); i >= 0 {
+			v = v[:i]
+		}
+		if v != "" {
+			return v
+		}
+	}
+	return fallbackLocale
+}
+
+// load reads and flattens locales/<name>.toml into a dotted-key map.
+func load(name string) (map[string]string, error) {
+	data, err := localeFS.ReadFile("locales/" + name + ".toml")
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if _, err := toml.Decode(string(data), &raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	flatten("", raw, values)
+	return values, nil
+}
+
+func mustLoad(name string) map[string]string {
+	values, err := load(name)
+	if err != nil {
+		panic(fmt.Sprintf("tr: failed to load embedded locale %q: %v", name, err))
+	}
+	return values
+}
+
+func flatten(prefix string, raw map[string]interface{}, out map[string]string) {
+	for k, v := range raw {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case string:
+			out[key] = val
+		case map[string]interface{}:
+			flatten(key, val, out)
+		}
+	}
+}
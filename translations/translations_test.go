@@ -0,0 +1,53 @@
+package tr
+
+import "testing"
+
+func TestFlattenNestsDottedKeys(t *testing.T) {
+	raw := map[string]interface{}{
+		"widget": map[string]interface{}{
+			"cpu": "CPU Usage: %.2f%%",
+		},
+		"label": map[string]interface{}{
+			"hostname": "Hostname: %s",
+		},
+	}
+	out := make(map[string]string)
+	flatten("", raw, out)
+
+	if out["widget.cpu"] != "CPU Usage: %.2f%%" {
+		t.Fatalf("flatten: widget.cpu = %q", out["widget.cpu"])
+	}
+	if out["label.hostname"] != "Hostname: %s" {
+		t.Fatalf("flatten: label.hostname = %q", out["label.hostname"])
+	}
+	if len(out) != 2 {
+		t.Fatalf("flatten produced %d keys, want 2", len(out))
+	}
+}
+
+func TestValueFormatsArgs(t *testing.T) {
+	active = map[string]string{"greeting": "Hello, %s!"}
+	fallback = active
+
+	if got := Value("greeting", "world"); got != "Hello, world!" {
+		t.Fatalf("Value(\"greeting\", \"world\") = %q, want %q", got, "Hello, world!")
+	}
+}
+
+func TestValueFallsBackToDefaultLocale(t *testing.T) {
+	fallback = map[string]string{"only.in.fallback": "fallback value"}
+	active = map[string]string{}
+
+	if got := Value("only.in.fallback"); got != "fallback value" {
+		t.Fatalf("Value fell back to %q, want %q", got, "fallback value")
+	}
+}
+
+func TestValueMissingKeyReturnsKeyVerbatim(t *testing.T) {
+	active = map[string]string{}
+	fallback = map[string]string{}
+
+	if got := Value("does.not.exist"); got != "does.not.exist" {
+		t.Fatalf("Value(\"does.not.exist\") = %q, want the key back", got)
+	}
+}